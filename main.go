@@ -1,58 +1,185 @@
 package main
 
 import (
-	"errors"
+	"encoding/base64"
 	"fmt"
+	"net"
+	"net/url"
 	"reflect"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
-	"math"
-)
-const(
-	DefaultMaxValue = math.MaxInt8
-	DefaultMinValue = math.MinInt8
+	"text/template"
 )
+
 type Validator interface {
 	Validate(value interface{}) (bool, error)
 }
-type Option struct{
-	min int
-	max int
-	pattern string
+
+type Option struct {
+	args    []string
+	key     string
+	tag     string
+	message string
 }
 
 type OptionFunc func(*Option)
 
-func WithMin(min int)OptionFunc{
+func WithArgs(args ...string) OptionFunc {
 	return func(option *Option) {
-		option.min = min
+		option.args = args
 	}
 }
 
-func WithMax(max int)OptionFunc{
-	return func(option *Option){
-		option.max = max
+// WithKey carries the struct field name through to the Validator so it can label the
+// ValidationError it produces.
+func WithKey(key string) OptionFunc {
+	return func(option *Option) {
+		option.key = key
 	}
 }
 
-func WithPattern(pattern string)OptionFunc{
-	return func(option *Option){
-		option.pattern = pattern
+// WithTag carries the rule name (e.g. "Range", "string") through to the Validator so it can
+// tag the ValidationError it produces.
+func WithTag(tag string) OptionFunc {
+	return func(option *Option) {
+		option.tag = tag
 	}
 }
 
-var ValidatorFactory = make(map[string]func(...OptionFunc)Validator)
+// WithMessage overrides the MessageTmpls entry for this single Validator instance, e.g. to
+// localize one field's error independently of the rest of the table.
+func WithMessage(tmpl string) OptionFunc {
+	return func(option *Option) {
+		option.message = tmpl
+	}
+}
+
+var ValidatorFactory = make(map[string]func(...OptionFunc) Validator)
 
 func init() {
 	ValidatorFactory["default"] = newDefaultValidator
 	ValidatorFactory["int"] = newNumberValidator
 	ValidatorFactory["string"] = newStringValidator
 	ValidatorFactory["email"] = newEmailValidator
+	ValidatorFactory["Range"] = newRangeValidator
+	ValidatorFactory["Match"] = newMatchValidator
+	ValidatorFactory["NoMatch"] = newNoMatchValidator
+	ValidatorFactory["MinSize"] = newMinSizeValidator
+	ValidatorFactory["MaxSize"] = newMaxSizeValidator
+	ValidatorFactory["Required"] = newRequiredValidator
+	ValidatorFactory["Alpha"] = newAlphaValidator
+	ValidatorFactory["Numeric"] = newNumericValidator
+	ValidatorFactory["AlphaNumeric"] = newAlphaNumericValidator
+	ValidatorFactory["AlphaDash"] = newAlphaDashValidator
+	ValidatorFactory["IP"] = newIPValidator
+	ValidatorFactory["URL"] = newURLValidator
+	ValidatorFactory["Base64"] = newBase64Validator
+	ValidatorFactory["Mobile"] = newMobileValidator
+	ValidatorFactory["ZipCode"] = newZipCodeValidator
+}
+
+// MessageTmpls holds the text/template format string used to render each validator's
+// ValidationError, keyed by rule/tag name (e.g. "Range", "MinSize", "email"). Templates see
+// the Params carried on the ValidationError, so at minimum they can reference {{.Field}};
+// most also expose {{.Value}} plus whatever is specific to that rule, e.g. {{.Min}}/{{.Max}}.
+// Swap this table (via SetDefaultMessage) to localize output without touching validator code.
+var MessageTmpls = map[string]string{
+	"TypeError":      "{{.Field}} is not of {{.Type}} type",
+	"Required":       "{{.Field}} is required",
+	"string":         "{{.Field}} is not of string type",
+	"int":            "{{.Field}} is not of int type",
+	"email":          "{{.Field}} is not a valid email address",
+	"Range":          "{{.Field}}: integer {{.Value}}, allowed range [ {{.Min}},{{.Max}}]",
+	"Match":          "{{.Field}}: value {{.Value}} does not match pattern {{.Pattern}}",
+	"NoMatch":        "{{.Field}}: value {{.Value}} matches forbidden pattern {{.Pattern}}",
+	"MinSize":        "{{.Field}}: length {{.Length}}, allowed minimum {{.Min}}",
+	"MaxSize":        "{{.Field}}: length {{.Length}}, allowed maximum {{.Max}}",
+	"Alpha":          "{{.Field}} is not a valid alpha value",
+	"Numeric":        "{{.Field}} is not a valid numeric value",
+	"AlphaNumeric":   "{{.Field}} is not a valid alphanumeric value",
+	"AlphaDash":      "{{.Field}} is not a valid alpha-dash value",
+	"ZipCode":        "{{.Field}} is not a valid zip code",
+	"IP":             "{{.Field}} is not a valid IP address",
+	"URL":            "{{.Field}} is not a valid URL",
+	"Base64":         "{{.Field}} is not valid base64",
+	"Mobile":         "{{.Field}} is not a valid mobile number",
+	"InvalidPattern": "{{.Field}}: invalid pattern ({{.Error}})",
+	"SizeError":      "{{.Field}} has no size",
+}
+
+// SetDefaultMessage overwrites entries in MessageTmpls, e.g. to swap in a French or Spanish
+// table at startup. Callers only need to pass the rule names they want to change.
+func SetDefaultMessage(tmpls map[string]string) {
+	for name, tmpl := range tmpls {
+		MessageTmpls[name] = tmpl
+	}
+}
+
+// renderMessage renders tag's template (or override, if non-empty) against params, falling
+// back to a generic message if no template is registered or rendering fails.
+func renderMessage(tag, override string, params map[string]interface{}) string {
+	tmplStr := override
+	if tmplStr == "" {
+		var ok bool
+		tmplStr, ok = MessageTmpls[tag]
+		if !ok {
+			tmplStr = "{{.Field}} is invalid"
+		}
+	}
+	tmpl, err := template.New(tag).Parse(tmplStr)
+	if err != nil {
+		return fmt.Sprintf("%v is invalid", params["Field"])
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, params); err != nil {
+		return fmt.Sprintf("%v is invalid", params["Field"])
+	}
+	return buf.String()
+}
+
+// ValidationError is the structured result of a single failed validator, carrying enough
+// context for a caller to render per-field errors without re-parsing strings. Message is
+// rendered from MessageTmpls[Tag] (or a per-validator WithMessage override) at the time the
+// error is produced; Params holds the raw values the template was rendered against. Type
+// mismatches in other rules, values with no size and malformed patterns are rendered from the
+// shared "TypeError", "SizeError" and "InvalidPattern" entries instead, and errors from custom
+// funcs or unknown rules carry the error's own text with no Params.
+type ValidationError struct {
+	Field   string
+	Tag     string
+	Message string
+	Value   interface{}
+	Params  map[string]interface{}
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// typeError builds the ValidationError for a failed type assertion, shared across validators.
+func typeError(key, tag, wantType string, val interface{}) *ValidationError {
+	params := map[string]interface{}{"Field": key, "Type": wantType, "Value": val}
+	return &ValidationError{Field: key, Tag: tag, Params: params, Message: renderMessage("TypeError", "", params), Value: val}
+}
+
+// invalidValidator surfaces a validator construction failure (e.g. a malformed tag-supplied
+// regex) as a ValidationError at validate time, instead of panicking while building the tag's
+// validator chain.
+type invalidValidator struct {
+	key, tag string
+	err      error
+}
+
+func (i *invalidValidator) Validate(val interface{}) (bool, error) {
+	params := map[string]interface{}{"Field": i.key, "Value": val, "Error": i.err.Error()}
+	return false, &ValidationError{Field: i.key, Tag: i.tag, Params: params, Message: renderMessage("InvalidPattern", "", params), Value: val}
 }
 
 type DefaultValidator struct{}
 
-func newDefaultValidator(...OptionFunc)Validator{
+func newDefaultValidator(...OptionFunc) Validator {
 	return &DefaultValidator{}
 }
 
@@ -61,77 +188,443 @@ func (d *DefaultValidator) Validate(val interface{}) (bool, error) {
 }
 
 type StringValidator struct {
-	min, max int
+	key, tag, message string
 }
 
-func newStringValidator(opts ...OptionFunc)Validator{
+func newStringValidator(opts ...OptionFunc) Validator {
 	option := &Option{}
-	for _,opt := range opts{
+	for _, opt := range opts {
 		opt(option)
 	}
-	strValidator := &StringValidator{}
-	strValidator.min = option.min
-	strValidator.max = option.max
-	return strValidator
+	return &StringValidator{key: option.key, tag: option.tag, message: option.message}
 }
 
 func (s *StringValidator) Validate(val interface{}) (bool, error) {
+	if _, ok := val.(string); !ok {
+		params := map[string]interface{}{"Field": s.key, "Type": "string", "Value": val}
+		return false, &ValidationError{Field: s.key, Tag: s.tag, Params: params, Message: renderMessage(s.tag, s.message, params), Value: val}
+	}
+	return true, nil
+}
+
+type NumberValidator struct {
+	key, tag, message string
+}
+
+func newNumberValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	return &NumberValidator{key: option.key, tag: option.tag, message: option.message}
+}
+
+func (n *NumberValidator) Validate(val interface{}) (bool, error) {
+	if _, ok := val.(int); !ok {
+		params := map[string]interface{}{"Field": n.key, "Type": "int", "Value": val}
+		return false, &ValidationError{Field: n.key, Tag: n.tag, Params: params, Message: renderMessage(n.tag, n.message, params), Value: val}
+	}
+	return true, nil
+}
+
+type EmailValidator struct {
+	re                *regexp.Regexp
+	key, tag, message string
+}
+
+func newEmailValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return &invalidValidator{key: option.key, tag: option.tag, err: err}
+	}
+	return &EmailValidator{re: re, key: option.key, tag: option.tag, message: option.message}
+}
+
+func (e *EmailValidator) Validate(email interface{}) (bool, error) {
+	str, ok := email.(string)
+	if !ok {
+		return false, typeError(e.key, e.tag, "string", email)
+	}
+	if !e.re.MatchString(str) {
+		params := map[string]interface{}{"Field": e.key, "Value": email}
+		return false, &ValidationError{Field: e.key, Tag: e.tag, Params: params, Message: renderMessage(e.tag, e.message, params), Value: email}
+	}
+	return true, nil
+}
+
+// RangeValidator checks that an int field falls within [min, max], e.g. `Range(18,30)`.
+type RangeValidator struct {
+	min, max          int
+	key, tag, message string
+}
+
+func newRangeValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	r := &RangeValidator{key: option.key, tag: option.tag, message: option.message}
+	if len(option.args) >= 2 {
+		r.min, _ = strconv.Atoi(option.args[0])
+		r.max, _ = strconv.Atoi(option.args[1])
+	}
+	return r
+}
+
+func (r *RangeValidator) Validate(val interface{}) (bool, error) {
+	num, ok := val.(int)
+	if !ok {
+		return false, typeError(r.key, r.tag, "int", val)
+	}
+	if num < r.min || num > r.max {
+		params := map[string]interface{}{"Field": r.key, "Value": num, "Min": r.min, "Max": r.max}
+		return false, &ValidationError{Field: r.key, Tag: r.tag, Params: params, Message: renderMessage(r.tag, r.message, params), Value: val}
+	}
+	return true, nil
+}
+
+// MatchValidator checks a string field against a regex literal, e.g. `Match(/^[a-z]+$/)`.
+type MatchValidator struct {
+	re                *regexp.Regexp
+	key, tag, message string
+}
+
+func newMatchValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	var raw string
+	if len(option.args) >= 1 {
+		raw = option.args[0]
+	}
+	raw = strings.TrimSuffix(strings.TrimPrefix(raw, "/"), "/")
+	re, err := regexp.Compile(raw)
+	if err != nil {
+		return &invalidValidator{key: option.key, tag: option.tag, err: err}
+	}
+	return &MatchValidator{re: re, key: option.key, tag: option.tag, message: option.message}
+}
+
+func (m *MatchValidator) Validate(val interface{}) (bool, error) {
 	str, ok := val.(string)
 	if !ok {
-		return false, fmt.Errorf("not of string type")
+		return false, typeError(m.key, m.tag, "string", val)
 	}
+	if !m.re.MatchString(str) {
+		params := map[string]interface{}{"Field": m.key, "Value": str, "Pattern": m.re.String()}
+		return false, &ValidationError{Field: m.key, Tag: m.tag, Params: params, Message: renderMessage(m.tag, m.message, params), Value: val}
+	}
+	return true, nil
+}
+
+// NoMatchValidator is the inverse of MatchValidator, e.g. `NoMatch(/\s/)` to reject whitespace.
+type NoMatchValidator struct {
+	re                *regexp.Regexp
+	key, tag, message string
+}
 
-	if strLen := len(str); strLen < s.min || strLen > s.max {
-		return false, fmt.Errorf("string length %d, allowed range [ %d,%d ]", strLen, s.min, s.max)
+func newNoMatchValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	var raw string
+	if len(option.args) >= 1 {
+		raw = option.args[0]
+	}
+	raw = strings.TrimSuffix(strings.TrimPrefix(raw, "/"), "/")
+	re, err := regexp.Compile(raw)
+	if err != nil {
+		return &invalidValidator{key: option.key, tag: option.tag, err: err}
+	}
+	return &NoMatchValidator{re: re, key: option.key, tag: option.tag, message: option.message}
+}
+
+func (m *NoMatchValidator) Validate(val interface{}) (bool, error) {
+	str, ok := val.(string)
+	if !ok {
+		return false, typeError(m.key, m.tag, "string", val)
+	}
+	if m.re.MatchString(str) {
+		params := map[string]interface{}{"Field": m.key, "Value": str, "Pattern": m.re.String()}
+		return false, &ValidationError{Field: m.key, Tag: m.tag, Params: params, Message: renderMessage(m.tag, m.message, params), Value: val}
 	}
 	return true, nil
 }
 
-type NumberValidator struct {
-	min, max int
+// sizeOf returns the length of a string, slice, array or map, mirroring what MinSize/MaxSize check.
+func sizeOf(val interface{}) (int, bool) {
+	if str, ok := val.(string); ok {
+		return len(str), true
+	}
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return rv.Len(), true
+	default:
+		return 0, false
+	}
+}
+
+type MinSizeValidator struct {
+	min               int
+	key, tag, message string
 }
 
-func newNumberValidator(opts ...OptionFunc)Validator{
+func newMinSizeValidator(opts ...OptionFunc) Validator {
 	option := &Option{}
-	for _,opt := range opts{
+	for _, opt := range opts {
 		opt(option)
 	}
-	numValidator := &NumberValidator{}
-	numValidator.max = option.max
-	numValidator.min = option.min
-	return numValidator
+	m := &MinSizeValidator{key: option.key, tag: option.tag, message: option.message}
+	if len(option.args) >= 1 {
+		m.min, _ = strconv.Atoi(option.args[0])
+	}
+	return m
 }
 
-func (n *NumberValidator) Validate(val interface{}) (bool, error) {
-	num, ok := val.(int)
+func (m *MinSizeValidator) Validate(val interface{}) (bool, error) {
+	length, ok := sizeOf(val)
 	if !ok {
-		return false, errors.New("not of int type")
+		params := map[string]interface{}{"Field": m.key, "Value": val}
+		return false, &ValidationError{Field: m.key, Tag: m.tag, Params: params, Message: renderMessage("SizeError", "", params), Value: val}
+	}
+	if length < m.min {
+		params := map[string]interface{}{"Field": m.key, "Length": length, "Min": m.min}
+		return false, &ValidationError{Field: m.key, Tag: m.tag, Params: params, Message: renderMessage(m.tag, m.message, params), Value: val}
+	}
+	return true, nil
+}
+
+type MaxSizeValidator struct {
+	max               int
+	key, tag, message string
+}
+
+func newMaxSizeValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	m := &MaxSizeValidator{key: option.key, tag: option.tag, message: option.message}
+	if len(option.args) >= 1 {
+		m.max, _ = strconv.Atoi(option.args[0])
 	}
+	return m
+}
 
-	if num < n.min || num > n.max {
-		return false, fmt.Errorf("interger %d, allowed range [ %d,%d]", num, n.min, n.max)
+func (m *MaxSizeValidator) Validate(val interface{}) (bool, error) {
+	length, ok := sizeOf(val)
+	if !ok {
+		params := map[string]interface{}{"Field": m.key, "Value": val}
+		return false, &ValidationError{Field: m.key, Tag: m.tag, Params: params, Message: renderMessage("SizeError", "", params), Value: val}
+	}
+	if length > m.max {
+		params := map[string]interface{}{"Field": m.key, "Length": length, "Max": m.max}
+		return false, &ValidationError{Field: m.key, Tag: m.tag, Params: params, Message: renderMessage(m.tag, m.message, params), Value: val}
 	}
 	return true, nil
 }
 
-type EmailValidator struct {
-	pattern string
+// RequiredValidator rejects the zero value for the field's type, e.g. `Required`.
+type RequiredValidator struct {
+	key, tag, message string
 }
 
-func newEmailValidator(opts ...OptionFunc)Validator{
+func newRequiredValidator(opts ...OptionFunc) Validator {
 	option := &Option{}
-	for _,opt := range opts{
+	for _, opt := range opts {
 		opt(option)
 	}
-	emailValidator := &EmailValidator{}
-	emailValidator.pattern = option.pattern
-	return emailValidator
+	return &RequiredValidator{key: option.key, tag: option.tag, message: option.message}
 }
 
-func (e *EmailValidator) Validate(email interface{}) (bool, error) {
-	regExp := regexp.MustCompile(e.pattern)
-	if !regExp.MatchString(email.(string)) {
-		return false, errors.New("not a valid email address")
+func (r *RequiredValidator) Validate(val interface{}) (bool, error) {
+	rv := reflect.ValueOf(val)
+	if !rv.IsValid() || rv.IsZero() {
+		params := map[string]interface{}{"Field": r.key, "Value": val}
+		return false, &ValidationError{Field: r.key, Tag: r.tag, Params: params, Message: renderMessage(r.tag, r.message, params), Value: val}
+	}
+	return true, nil
+}
+
+// regexValidator is the shared implementation behind the simple "does this string match a
+// fixed, pre-compiled pattern" built-ins: Alpha, Numeric, AlphaNumeric, AlphaDash, ZipCode.
+type regexValidator struct {
+	re                *regexp.Regexp
+	key, tag, message string
+}
+
+func (r *regexValidator) Validate(val interface{}) (bool, error) {
+	str, ok := val.(string)
+	if !ok {
+		return false, typeError(r.key, r.tag, "string", val)
+	}
+	if !r.re.MatchString(str) {
+		params := map[string]interface{}{"Field": r.key, "Value": str}
+		return false, &ValidationError{Field: r.key, Tag: r.tag, Params: params, Message: renderMessage(r.tag, r.message, params), Value: val}
+	}
+	return true, nil
+}
+
+var (
+	alphaPattern        = regexp.MustCompile(`^[a-zA-Z]+$`)
+	numericPattern      = regexp.MustCompile(`^[0-9]+$`)
+	alphaNumericPattern = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+	alphaDashPattern    = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	zipCodePattern      = regexp.MustCompile(`^[0-9]{5}(-[0-9]{4})?$`)
+)
+
+func newAlphaValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	return &regexValidator{re: alphaPattern, key: option.key, tag: option.tag, message: option.message}
+}
+
+func newNumericValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	return &regexValidator{re: numericPattern, key: option.key, tag: option.tag, message: option.message}
+}
+
+func newAlphaNumericValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	return &regexValidator{re: alphaNumericPattern, key: option.key, tag: option.tag, message: option.message}
+}
+
+func newAlphaDashValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	return &regexValidator{re: alphaDashPattern, key: option.key, tag: option.tag, message: option.message}
+}
+
+func newZipCodeValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	return &regexValidator{re: zipCodePattern, key: option.key, tag: option.tag, message: option.message}
+}
+
+// IPValidator accepts both IPv4 and IPv6 addresses via net.ParseIP, e.g. `IP`.
+type IPValidator struct {
+	key, tag, message string
+}
+
+func newIPValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	return &IPValidator{key: option.key, tag: option.tag, message: option.message}
+}
+
+func (i *IPValidator) Validate(val interface{}) (bool, error) {
+	str, ok := val.(string)
+	if !ok || net.ParseIP(str) == nil {
+		params := map[string]interface{}{"Field": i.key, "Value": val}
+		return false, &ValidationError{Field: i.key, Tag: i.tag, Params: params, Message: renderMessage(i.tag, i.message, params), Value: val}
+	}
+	return true, nil
+}
+
+// URLValidator requires a parseable URL with a scheme and host, e.g. `URL`.
+type URLValidator struct {
+	key, tag, message string
+}
+
+func newURLValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	return &URLValidator{key: option.key, tag: option.tag, message: option.message}
+}
+
+func (u *URLValidator) Validate(val interface{}) (bool, error) {
+	str, ok := val.(string)
+	if ok {
+		if parsed, err := url.Parse(str); err == nil && parsed.Scheme != "" && parsed.Host != "" {
+			return true, nil
+		}
+	}
+	params := map[string]interface{}{"Field": u.key, "Value": val}
+	return false, &ValidationError{Field: u.key, Tag: u.tag, Params: params, Message: renderMessage(u.tag, u.message, params), Value: val}
+}
+
+// Base64Validator checks that a string decodes as standard base64, e.g. `Base64`.
+type Base64Validator struct {
+	key, tag, message string
+}
+
+func newBase64Validator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	return &Base64Validator{key: option.key, tag: option.tag, message: option.message}
+}
+
+func (b *Base64Validator) Validate(val interface{}) (bool, error) {
+	str, ok := val.(string)
+	if !ok {
+		return false, typeError(b.key, b.tag, "string", val)
+	}
+	if _, err := base64.StdEncoding.DecodeString(str); err != nil {
+		params := map[string]interface{}{"Field": b.key, "Value": val}
+		return false, &ValidationError{Field: b.key, Tag: b.tag, Params: params, Message: renderMessage(b.tag, b.message, params), Value: val}
+	}
+	return true, nil
+}
+
+// mobilePatterns maps a country code option, e.g. `Mobile(IN)`, to its phone number pattern.
+// Countries not listed fall back to mobileDefaultPattern.
+var mobilePatterns = map[string]*regexp.Regexp{
+	"IN": regexp.MustCompile(`^[6-9]\d{9}$`),
+	"US": regexp.MustCompile(`^\d{10}$`),
+}
+var mobileDefaultPattern = regexp.MustCompile(`^\d{7,15}$`)
+
+// MobileValidator checks a string against a country-specific phone number pattern, e.g.
+// `Mobile(IN)`; with no country argument it falls back to a generic digit-count check.
+type MobileValidator struct {
+	re                *regexp.Regexp
+	key, tag, message string
+}
+
+func newMobileValidator(opts ...OptionFunc) Validator {
+	option := &Option{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	re := mobileDefaultPattern
+	if len(option.args) >= 1 {
+		if countryPattern, ok := mobilePatterns[option.args[0]]; ok {
+			re = countryPattern
+		}
+	}
+	return &MobileValidator{re: re, key: option.key, tag: option.tag, message: option.message}
+}
+
+func (m *MobileValidator) Validate(val interface{}) (bool, error) {
+	str, ok := val.(string)
+	if !ok || !m.re.MatchString(str) {
+		params := map[string]interface{}{"Field": m.key, "Value": val}
+		return false, &ValidationError{Field: m.key, Tag: m.tag, Params: params, Message: renderMessage(m.tag, m.message, params), Value: val}
 	}
 	return true, nil
 }
@@ -139,64 +632,435 @@ func (e *EmailValidator) Validate(email interface{}) (bool, error) {
 var tagName = `validate`
 var pattern = `\A[\w+\-.]+@[a-z\d\-]+(\.[a-z]+)*\.[a-z]+\z`
 
+type Address struct {
+	Street string `validate:"string;MinSize(1)"`
+	Zip    string `validate:"string;MinSize(5);MaxSize(5)"`
+}
+
+type Contact struct {
+	Email string `validate:"email"`
+}
+
 type User struct {
-	Name      string `validate:"string"`
+	Name      string `validate:"Required;string;MinSize(2);MaxSize(50)"`
 	Email     string `validate:"email"`
-	Age       int    `validate:"int,min=18,max=30"`
-	ContactNo string `validate:"string,min=10,max=13"`
+	Age       int    `validate:"int;Range(18,30)"`
+	ContactNo string `validate:"string;MinSize(10);MaxSize(13);Mobile(IN)"`
+	Nickname  string `validate:"IsMe"`
+	Address   Address
+	Contacts  []Contact `validate:"dive"`
+}
+
+// ruleToken is a single `Name(arg1,arg2)` rule parsed out of a `;`-separated validate tag.
+type ruleToken struct {
+	Name string
+	Args []string
+}
+
+// splitTopLevel splits s on sep, ignoring sep occurrences inside `(...)` calls or `/.../` regex literals.
+func splitTopLevel(s string, sep byte) []string {
+	var parts []string
+	var buf strings.Builder
+	depth := 0
+	inRegex := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '/' && (i == 0 || s[i-1] != '\\'):
+			inRegex = !inRegex
+			buf.WriteByte(c)
+		case !inRegex && c == '(':
+			depth++
+			buf.WriteByte(c)
+		case !inRegex && c == ')':
+			depth--
+			buf.WriteByte(c)
+		case !inRegex && depth == 0 && c == sep:
+			parts = append(parts, buf.String())
+			buf.Reset()
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	parts = append(parts, buf.String())
+	return parts
+}
+
+// splitNameArgs splits a single rule like `Range(1,140)` into its name and raw argument string.
+func splitNameArgs(rule string) (name string, argStr string, hasArgs bool) {
+	idx := strings.IndexByte(rule, '(')
+	if idx == -1 || !strings.HasSuffix(rule, ")") {
+		return rule, "", false
+	}
+	return rule[:idx], rule[idx+1 : len(rule)-1], true
+}
+
+// tokenizeRules parses a `validate` tag into an ordered list of rules, e.g.
+// `Required;Range(1,140);Match(/^[a-z]+$/)` becomes three tokens.
+func tokenizeRules(tag string) ([]ruleToken, error) {
+	rules := splitTopLevel(tag, ';')
+	tokens := make([]ruleToken, 0, len(rules))
+	for _, rule := range rules {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		name, argStr, hasArgs := splitNameArgs(rule)
+		var args []string
+		if hasArgs {
+			args = splitTopLevel(argStr, ',')
+			for i := range args {
+				args[i] = strings.TrimSpace(args[i])
+			}
+		}
+		tokens = append(tokens, ruleToken{Name: name, Args: args})
+	}
+	return tokens, nil
+}
+
+// reservedWords are tag rule names that can never be claimed by AddCustomFunc because
+// they carry special meaning to the tag parser itself.
+var reservedWords = map[string]bool{
+	"dive": true,
+}
+
+// customFuncValidator adapts a user-registered AddCustomFunc callback to the Validator interface.
+type customFuncValidator struct {
+	fn  func(val interface{}, key string) (bool, error)
+	key string
 }
 
-func GetValidatorFromTag(tag string) (Validator, error) {
-	args := strings.Split(tag, ",")
-	var min, max int
-	if len(args) == 0 {
+func (c *customFuncValidator) Validate(val interface{}) (bool, error) {
+	return c.fn(val, c.key)
+}
+
+// Validation holds a registry of custom validator funcs that participate in tag dispatch
+// alongside the built-ins in ValidatorFactory.
+type Validation struct {
+	customFuncs map[string]func(val interface{}, key string) (bool, error)
+}
+
+func NewValidation() *Validation {
+	return &Validation{
+		customFuncs: make(map[string]func(val interface{}, key string) (bool, error)),
+	}
+}
+
+// AddCustomFunc registers fn under name so it can be referenced from a struct tag, e.g.
+// `validate:"IsMe"`. It returns an error if name collides with a built-in validator or a
+// reserved word.
+func (v *Validation) AddCustomFunc(name string, fn func(val interface{}, key string) (bool, error)) error {
+	if _, ok := ValidatorFactory[name]; ok {
+		return fmt.Errorf("%s is already registered as a built-in validator", name)
+	}
+	if reservedWords[name] {
+		return fmt.Errorf("%s is a reserved word", name)
+	}
+	v.customFuncs[name] = fn
+	return nil
+}
+
+// UnregisterFunc removes a previously registered custom func, if any.
+func (v *Validation) UnregisterFunc(name string) {
+	delete(v.customFuncs, name)
+}
+
+// ListValidators returns a sorted snapshot of the currently registered custom validator names.
+func (v *Validation) ListValidators() []string {
+	names := make([]string, 0, len(v.customFuncs))
+	for name := range v.customFuncs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetValidatorFromTag resolves tag into an ordered list of Validators, checking custom funcs
+// before falling back to ValidatorFactory. key is the field name, passed through to custom funcs.
+func (v *Validation) GetValidatorFromTag(tag, key string) ([]Validator, error) {
+	tokens, err := tokenizeRules(tag)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
 		return nil, fmt.Errorf("validator type not present")
 	}
-	validator, ok := ValidatorFactory[args[0]]
-	if !ok {
-		return nil, fmt.Errorf("validator for %s not present", args[0])
+
+	return v.buildValidators(tokens, key)
+}
+
+// buildValidators resolves an already-tokenized rule list into Validators, skipping the
+// `dive` marker (it only controls element traversal, handled separately by Validate).
+func (v *Validation) buildValidators(tokens []ruleToken, key string) ([]Validator, error) {
+	validators := make([]Validator, 0, len(tokens))
+	for _, token := range tokens {
+		if token.Name == "dive" {
+			continue
+		}
+		if fn, ok := v.customFuncs[token.Name]; ok {
+			validators = append(validators, &customFuncValidator{fn: fn, key: key})
+			continue
+		}
+		factory, ok := ValidatorFactory[token.Name]
+		if !ok {
+			return nil, fmt.Errorf("validator for %s not present", token.Name)
+		}
+		validators = append(validators, factory(WithArgs(token.Args...), WithKey(key), WithTag(token.Name)))
 	}
+	return validators, nil
+}
 
-	if len(args) == 1 {
-		min = int(DefaultMinValue)
-		max = int(DefaultMaxValue)
-	} else {
-		fmt.Sscanf(strings.Join(args[1:], ","), "min=%d,max=%d", &min, &max)
+// splitOnDive separates a tokenized tag into the rules that apply to the field itself and,
+// if a `dive` marker is present, the rules that apply to each element of a slice/map field.
+func splitOnDive(tokens []ruleToken) (container, element []ruleToken, dives bool) {
+	for i, t := range tokens {
+		if t.Name == "dive" {
+			return tokens[:i], tokens[i+1:], true
+		}
 	}
+	return tokens, nil, false
+}
 
-	return validator(WithMin(min),WithMax(max),WithPattern(pattern)),nil
+// Result is the outcome of validating a struct: zero or more ValidationErrors, one per
+// failed rule, in the order the fields and rules were evaluated.
+type Result struct {
+	errors []*ValidationError
 }
 
-func ValidateUser(user interface{}) []error {
-	errs := make([]error, 0)
+// HasErrors reports whether any rule failed.
+func (r *Result) HasErrors() bool {
+	return len(r.errors) > 0
+}
+
+// Errors returns every failure, in evaluation order.
+func (r *Result) Errors() []*ValidationError {
+	return r.errors
+}
+
+// ErrorMap returns the first failure per field, keyed by field name.
+func (r *Result) ErrorMap() map[string]*ValidationError {
+	m := make(map[string]*ValidationError, len(r.errors))
+	for _, e := range r.errors {
+		if _, ok := m[e.Field]; !ok {
+			m[e.Field] = e
+		}
+	}
+	return m
+}
+
+// First returns the first failure recorded against field, or nil if it passed.
+func (r *Result) First(field string) *ValidationError {
+	for _, e := range r.errors {
+		if e.Field == field {
+			return e
+		}
+	}
+	return nil
+}
+
+// Validate is the primary entry point: it walks user's fields (recursing into nested structs,
+// slices and maps), runs every tag-declared validator, and returns a Result carrying
+// structured, per-field errors with dotted keys like "Address.Zip" or "Contacts[2].Email".
+func (v *Validation) Validate(user interface{}) *Result {
+	result := &Result{}
 	val := reflect.ValueOf(user)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return result
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return result
+	}
+	v.validateStruct(val, "", make(map[uintptr]bool), result)
+	return result
+}
+
+// validateStruct runs tag-declared validators over val's fields and recurses into any
+// nested struct or pointer field, and into the elements of slice, array or map fields whose
+// tag carries a `dive` marker. prefix is the dotted key of val itself
+// ("" at the top level). visited guards against infinite recursion on cyclic graphs.
+// Unexported fields are skipped: reflect won't let us call Interface() on them.
+func (v *Validation) validateStruct(val reflect.Value, prefix string, visited map[uintptr]bool, result *Result) {
 	for i := 0; i < val.NumField(); i++ {
-		tag := val.Type().Field(i).Tag.Get(tagName)
-		if tag == "" || tag == "_"{
+		field := val.Type().Field(i)
+		if field.PkgPath != "" {
 			continue
 		}
-		validator,err := GetValidatorFromTag(tag)
+		fieldVal := val.Field(i)
+		tag := field.Tag.Get(tagName)
+		key := field.Name
+		if prefix != "" {
+			key = prefix + "." + field.Name
+		}
+
+		if tag != "" && tag != "_" {
+			tokens, err := tokenizeRules(tag)
+			if err != nil {
+				result.errors = append(result.errors, &ValidationError{Field: key, Tag: tag, Message: err.Error()})
+			} else {
+				container, _, _ := splitOnDive(tokens)
+				validators, err := v.buildValidators(container, key)
+				if err != nil {
+					result.errors = append(result.errors, &ValidationError{Field: key, Tag: tag, Message: err.Error()})
+				} else {
+					for _, validator := range validators {
+						valid, err := validator.Validate(fieldVal.Interface())
+						if err == nil || valid {
+							continue
+						}
+						if ve, ok := err.(*ValidationError); ok {
+							result.errors = append(result.errors, ve)
+						} else {
+							result.errors = append(result.errors, &ValidationError{Field: key, Message: err.Error()})
+						}
+					}
+				}
+			}
+		}
+
+		v.descend(fieldVal, key, tag, visited, result)
+	}
+}
+
+// descend recurses into fieldVal when it is a (non-nil) pointer or struct, and into its
+// elements when it is a slice/array/map carrying a `dive` marker in tag.
+func (v *Validation) descend(fieldVal reflect.Value, key, tag string, visited map[uintptr]bool, result *Result) {
+	switch fieldVal.Kind() {
+	case reflect.Ptr:
+		if fieldVal.IsNil() {
+			return
+		}
+		ptr := fieldVal.Pointer()
+		if visited[ptr] {
+			return
+		}
+		visited[ptr] = true
+		v.descend(fieldVal.Elem(), key, tag, visited, result)
+		delete(visited, ptr)
+	case reflect.Struct:
+		v.validateStruct(fieldVal, key, visited, result)
+	case reflect.Slice, reflect.Array:
+		tokens, err := tokenizeRules(tag)
 		if err != nil {
-			errs = append(errs,err)
-			continue
+			return
+		}
+		_, element, dives := splitOnDive(tokens)
+		if !dives {
+			return
+		}
+		for i := 0; i < fieldVal.Len(); i++ {
+			v.diveInto(fieldVal.Index(i), fmt.Sprintf("%s[%d]", key, i), element, visited, result)
+		}
+	case reflect.Map:
+		tokens, err := tokenizeRules(tag)
+		if err != nil {
+			return
+		}
+		_, element, dives := splitOnDive(tokens)
+		if !dives {
+			return
+		}
+		for _, mapKey := range fieldVal.MapKeys() {
+			v.diveInto(fieldVal.MapIndex(mapKey), fmt.Sprintf("%s[%v]", key, mapKey.Interface()), element, visited, result)
+		}
+	}
+}
+
+// diveInto validates a single slice/map element: it recurses into nested structs/pointers,
+// and applies element-level rules (the tokens following `dive`) to scalar elements.
+func (v *Validation) diveInto(elem reflect.Value, key string, element []ruleToken, visited map[uintptr]bool, result *Result) {
+	switch elem.Kind() {
+	case reflect.Ptr:
+		if elem.IsNil() {
+			return
+		}
+		ptr := elem.Pointer()
+		if visited[ptr] {
+			return
+		}
+		visited[ptr] = true
+		v.diveInto(elem.Elem(), key, element, visited, result)
+		delete(visited, ptr)
+	case reflect.Struct:
+		v.validateStruct(elem, key, visited, result)
+	default:
+		if len(element) == 0 {
+			return
+		}
+		validators, err := v.buildValidators(element, key)
+		if err != nil {
+			result.errors = append(result.errors, &ValidationError{Field: key, Message: err.Error()})
+			return
 		}
-		valid,err := validator.Validate(val.Field(i).Interface())
-		if err != nil && !valid{
-			errs = append(errs,err)
+		for _, validator := range validators {
+			valid, err := validator.Validate(elem.Interface())
+			if err == nil || valid {
+				continue
+			}
+			if ve, ok := err.(*ValidationError); ok {
+				result.errors = append(result.errors, ve)
+			} else {
+				result.errors = append(result.errors, &ValidationError{Field: key, Message: err.Error()})
+			}
 		}
 	}
+}
+
+// ValidateUser is a backward-compatible wrapper over Validate for callers that just want a
+// flat list of errors.
+func (v *Validation) ValidateUser(user interface{}) []error {
+	result := v.Validate(user)
+	errs := make([]error, 0, len(result.errors))
+	for _, e := range result.errors {
+		errs = append(errs, e)
+	}
 	return errs
 }
 
+// defaultValidation backs the package-level Validate/GetValidatorFromTag/ValidateUser helpers below.
+var defaultValidation = NewValidation()
+
+func GetValidatorFromTag(tag string) ([]Validator, error) {
+	return defaultValidation.GetValidatorFromTag(tag, "")
+}
+
+func Validate(user interface{}) *Result {
+	return defaultValidation.Validate(user)
+}
+
+func ValidateUser(user interface{}) []error {
+	return defaultValidation.ValidateUser(user)
+}
+
 func main() {
+	SetDefaultMessage(map[string]string{
+		"Range": "{{.Field}} doit être compris entre {{.Min}} et {{.Max}} (valeur reçue : {{.Value}})",
+	})
+
+	defaultValidation.AddCustomFunc("IsMe", func(val interface{}, key string) (bool, error) {
+		if name, _ := val.(string); name != "oshank" {
+			return false, fmt.Errorf("%s must equal %q", key, "oshank")
+		}
+		return true, nil
+	})
+
 	user := User{
 		Name:      "oshank",
 		Email:     "oshankfriends@gmail.com",
 		Age:       85,
 		ContactNo: "7065349354",
+		Nickname:  "oshank",
+		Address:   Address{Street: "Baker Street", Zip: "1234"},
+		Contacts: []Contact{
+			{Email: "not-an-email"},
+			{Email: "friend@example.com"},
+		},
 	}
+	result := Validate(user)
 	fmt.Println("Errors:")
-	for i,err := range ValidateUser(user){
-		fmt.Printf("%d. %s\n",i+1,err.Error())
+	for i, err := range result.Errors() {
+		fmt.Printf("%d. %s: %s\n", i+1, err.Field, err.Message)
 	}
 }
@@ -0,0 +1,26 @@
+package main
+
+import "testing"
+
+// withUnexported has a tagged unexported field alongside tagged and untagged exported fields,
+// mirroring the shape that used to panic in validateStruct before it started skipping
+// fields it can't call Interface() on.
+type withUnexported struct {
+	Name     string `validate:"Required"`
+	internal string `validate:"Required"`
+	Nested   Address
+}
+
+func TestValidateSkipsUnexportedFields(t *testing.T) {
+	v := NewValidation()
+	u := withUnexported{internal: "secret"}
+
+	result := v.Validate(&u)
+
+	if !result.HasErrors() {
+		t.Fatalf("expected Required failure on Name, got no errors")
+	}
+	if result.First("Name") == nil {
+		t.Fatalf("expected a ValidationError for Name, got: %v", result.Errors())
+	}
+}